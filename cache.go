@@ -0,0 +1,106 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// cacheFile is the on-disk store of parsed sessions, content hashes, and
+// last-fetched timestamps that lets the server come up instantly on flaky
+// conference wifi instead of blocking on a full re-scrape.
+var cacheFile = flag.String("cache-file", "sessions_cache.json", "File to persist the incremental session cache (content hash + last-fetched time)")
+
+// refreshInterval is how often to re-scrape sessions in the background after
+// the initial load. Zero disables the background refresher.
+var refreshInterval = flag.Duration("refresh-interval", 15*time.Minute, "How often to re-scrape sessions in the background (0 disables background refresh)")
+
+// cacheEntry records a previously parsed Session alongside the content hash
+// of the page it was parsed from, so unchanged pages can be skipped.
+type cacheEntry struct {
+	Session     Session   `json:"session"`
+	ContentHash string    `json:"content_hash"`
+	LastFetched time.Time `json:"last_fetched"`
+}
+
+var (
+	cacheMutex sync.RWMutex
+	cacheMap   = make(map[string]cacheEntry)
+)
+
+// contentHash returns a stable SHA-256 hex hash of page HTML, used to detect
+// whether a session's page has changed since it was last parsed.
+func contentHash(html string) string {
+	sum := sha256.Sum256([]byte(html))
+	return hex.EncodeToString(sum[:])
+}
+
+// cacheGet returns the cache entry for a session ID, if one exists.
+func cacheGet(id string) (cacheEntry, bool) {
+	cacheMutex.RLock()
+	defer cacheMutex.RUnlock()
+	entry, ok := cacheMap[id]
+	return entry, ok
+}
+
+// cachePut records the latest parsed Session and content hash for a session
+// ID, stamped with the current time.
+func cachePut(id string, session Session, hash string) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	cacheMap[id] = cacheEntry{
+		Session:     session,
+		ContentHash: hash,
+		LastFetched: time.Now(),
+	}
+}
+
+// cacheSnapshot returns a copy of every cached session, keyed by ID.
+func cacheSnapshot() map[string]cacheEntry {
+	cacheMutex.RLock()
+	defer cacheMutex.RUnlock()
+	snapshot := make(map[string]cacheEntry, len(cacheMap))
+	for id, entry := range cacheMap {
+		snapshot[id] = entry
+	}
+	return snapshot
+}
+
+// loadCache populates cacheMap from filename. It is not an error for the
+// file to be missing; that just means there is nothing to warm up from yet.
+func loadCache(filename string) error {
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var entries map[string]cacheEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	for id, entry := range entries {
+		cacheMap[id] = entry
+	}
+	log.Printf("Loaded %d sessions from cache %s", len(entries), filename)
+	return nil
+}
+
+// saveCache persists the current cacheMap to filename as JSON.
+func saveCache(filename string) error {
+	data, err := json.MarshalIndent(cacheSnapshot(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
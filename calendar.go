@@ -0,0 +1,202 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// agendaTimeZone is the IANA time zone session Date/Time strings are parsed
+// in. GopherCon 2025 is held in San Diego (Pacific time); San Diego itself
+// isn't a valid IANA zone, so this defaults to the real zone that covers it.
+var agendaTimeZone = flag.String("agenda-location", "America/Los_Angeles", "IANA time zone to parse session dates/times in")
+
+// agendaLocation returns the configured agenda time zone, falling back to
+// UTC if it can't be loaded.
+func agendaLocation() *time.Location {
+	loc, err := time.LoadLocation(*agendaTimeZone)
+	if err != nil {
+		log.Printf("Unknown -agenda-location %q, defaulting to UTC: %v", *agendaTimeZone, err)
+		return time.UTC
+	}
+	return loc
+}
+
+// dateLayouts and timeLayouts are the formats scraped Date/Time text is
+// tried against, in order, when building the iCalendar feed.
+var (
+	dateLayouts = []string{
+		"Monday, January 2, 2006",
+		"January 2, 2006",
+		"Jan 2, 2006",
+		"2006-01-02",
+	}
+	timeLayouts = []string{
+		"3:04 PM",
+		"3:04PM",
+		"15:04",
+	}
+)
+
+// sessionStartTime parses a session's Date and Time fields into a time.Time
+// in loc. Time may be a range like "9:00 AM - 9:50 AM"; only the start is
+// used. It reports false if either field is empty or the two together can't
+// be parsed.
+func sessionStartTime(session Session, loc *time.Location) (time.Time, bool) {
+	date := strings.TrimSpace(session.Date)
+	clock := strings.TrimSpace(session.Time)
+	if idx := strings.Index(clock, "-"); idx >= 0 {
+		clock = strings.TrimSpace(clock[:idx])
+	}
+	if date == "" || clock == "" {
+		return time.Time{}, false
+	}
+
+	for _, dl := range dateLayouts {
+		for _, tl := range timeLayouts {
+			if t, err := time.ParseInLocation(dl+" "+tl, date+" "+clock, loc); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// dateStartTime parses a session Date string (e.g. "Monday, September 15,
+// 2025") into midnight of that day in loc, so day headings can be sorted
+// chronologically instead of lexically. It reports false if date can't be
+// parsed against dateLayouts.
+func dateStartTime(date string, loc *time.Location) (time.Time, bool) {
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return time.Time{}, false
+	}
+	for _, dl := range dateLayouts {
+		if t, err := time.ParseInLocation(dl, date, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// durationMinutesPattern extracts the leading number of minutes from
+// strings like "50 min" or "50 minutes".
+var durationMinutesPattern = regexp.MustCompile(`\d+`)
+
+// sessionDuration parses a session's Duration field, defaulting to one hour
+// if it's missing or unparseable.
+func sessionDuration(session Session) time.Duration {
+	if match := durationMinutesPattern.FindString(session.Duration); match != "" {
+		if minutes, err := strconv.Atoi(match); err == nil {
+			return time.Duration(minutes) * time.Minute
+		}
+	}
+	return time.Hour
+}
+
+// buildAgendaICS builds an RFC 5545 iCalendar feed of every session with a
+// parseable start time. Sessions are keyed by UID = session ID, so clients
+// that subscribe once keep seeing updates as the background refresher runs.
+func buildAgendaICS(loc *time.Location) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//GopherCon 2025 Agenda Server//EN\r\n")
+	b.WriteString("CALSCALE:GREGORIAN\r\n")
+
+	for _, session := range sessions() {
+		start, ok := sessionStartTime(session, loc)
+		if !ok {
+			continue
+		}
+		end := start.Add(sessionDuration(session))
+
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:%s\r\n", session.ID)
+		fmt.Fprintf(&b, "DTSTART:%s\r\n", start.UTC().Format("20060102T150405Z"))
+		fmt.Fprintf(&b, "DTEND:%s\r\n", end.UTC().Format("20060102T150405Z"))
+		fmt.Fprintf(&b, "SUMMARY:%s\r\n", icsEscape(session.Title))
+		if session.Description != "" {
+			fmt.Fprintf(&b, "DESCRIPTION:%s\r\n", icsEscape(session.Description))
+		}
+		if session.Location != "" {
+			fmt.Fprintf(&b, "LOCATION:%s\r\n", icsEscape(session.Location))
+		}
+		if session.URL != "" {
+			fmt.Fprintf(&b, "URL:%s\r\n", session.URL)
+		}
+		b.WriteString("END:VEVENT\r\n")
+	}
+
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+// icsEscape escapes text per RFC 5545 section 3.3.11.
+func icsEscape(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	return replacer.Replace(s)
+}
+
+// buildScheduleMarkdown renders every session as a Markdown document,
+// grouped by day and sorted by start time within each day.
+func buildScheduleMarkdown() string {
+	loc := agendaLocation()
+
+	byDate := make(map[string][]Session)
+	for _, session := range sessions() {
+		date := session.Date
+		if date == "" {
+			date = "Unscheduled"
+		}
+		byDate[date] = append(byDate[date], session)
+	}
+
+	dates := make([]string, 0, len(byDate))
+	for date := range byDate {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		ti, oki := dateStartTime(dates[i], loc)
+		tj, okj := dateStartTime(dates[j], loc)
+		if !oki || !okj {
+			// Sessions with unparseable dates (e.g. "Unscheduled") sort last.
+			return oki && !okj
+		}
+		return ti.Before(tj)
+	})
+
+	var b strings.Builder
+	b.WriteString("# GopherCon 2025 Schedule\n\n")
+	for _, date := range dates {
+		fmt.Fprintf(&b, "## %s\n\n", date)
+
+		daySessions := byDate[date]
+		sort.Slice(daySessions, func(i, j int) bool {
+			ti, oki := sessionStartTime(daySessions[i], loc)
+			tj, okj := sessionStartTime(daySessions[j], loc)
+			if !oki || !okj {
+				return oki && !okj
+			}
+			return ti.Before(tj)
+		})
+
+		for _, session := range daySessions {
+			fmt.Fprintf(&b, "- **%s** %s", session.Time, session.Title)
+			if len(session.Speakers) > 0 {
+				fmt.Fprintf(&b, " — %s", strings.Join(session.Speakers, ", "))
+			}
+			if session.Location != "" {
+				fmt.Fprintf(&b, " (%s)", session.Location)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
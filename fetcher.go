@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Fetcher fetches the rendered HTML content of a page. It lets the scraper
+// swap a lightweight net/http implementation in for the full headless
+// Chrome one, which is fragile and heavyweight in CI/containers.
+type Fetcher interface {
+	// FetchPage returns the HTML content of url. requireSelector, if
+	// non-empty, is the CSS selector the caller expects the page to contain;
+	// implementations that can't guarantee client-side rendering (e.g. a
+	// plain net/http GET) use it to judge whether they actually got a usable
+	// page. Pass "" for pages with no such expectation, like a link listing.
+	FetchPage(ctx context.Context, url, requireSelector string) (string, error)
+	// Close releases any resources held by the Fetcher.
+	Close()
+}
+
+// newFetcher returns the default Fetcher: a plain net/http fetch, falling
+// back to a headless browser only when that fetch fails or the response is
+// missing the required selector (e.g. client-side JS content net/http can't
+// render).
+func newFetcher() Fetcher {
+	return newAutoFetcher(newCollyFetcher(*fetchConcurrency, *fetchRateLimit, defaultUserAgent), newChromedpFetcher)
+}
+
+// autoFetcher tries primary first and only pays the cost of spinning up a
+// headless browser (via newFallback) when primary fails or the page it
+// returns is missing the selector this server depends on.
+type autoFetcher struct {
+	primary Fetcher
+
+	mu          sync.Mutex
+	fallback    Fetcher
+	newFallback func() Fetcher
+}
+
+func newAutoFetcher(primary Fetcher, newFallback func() Fetcher) *autoFetcher {
+	return &autoFetcher{primary: primary, newFallback: newFallback}
+}
+
+func (a *autoFetcher) FetchPage(ctx context.Context, url, requireSelector string) (string, error) {
+	html, err := a.primary.FetchPage(ctx, url, requireSelector)
+	if err == nil && (requireSelector == "" || hasSelector(html, requireSelector)) {
+		return html, nil
+	}
+	if err != nil {
+		log.Printf("net/http fetch of %s failed, falling back to headless browser: %v", url, err)
+	} else {
+		log.Printf("net/http fetch of %s missing %s, falling back to headless browser", url, requireSelector)
+	}
+
+	return a.fallbackFetcher().FetchPage(ctx, url, requireSelector)
+}
+
+// fallbackFetcher lazily creates the headless-browser Fetcher the first
+// time it's actually needed.
+func (a *autoFetcher) fallbackFetcher() Fetcher {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.fallback == nil {
+		a.fallback = a.newFallback()
+	}
+	return a.fallback
+}
+
+func (a *autoFetcher) Close() {
+	a.primary.Close()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.fallback != nil {
+		a.fallback.Close()
+	}
+}
+
+// hasSelector reports whether html contains at least one element matching
+// selector.
+func hasSelector(html, selector string) bool {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return false
+	}
+	return doc.Find(selector).Length() > 0
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/chromedp/chromedp"
+)
+
+// chromedpFetcher renders pages in a headless Chrome instance. It's the
+// fallback Fetcher, used only when a plain HTTP GET isn't enough.
+type chromedpFetcher struct {
+	ctx                   context.Context
+	cancel, browserCancel context.CancelFunc
+}
+
+// newChromedpFetcher launches a headless Chrome instance and returns a
+// Fetcher backed by it.
+func newChromedpFetcher() Fetcher {
+	var f chromedpFetcher
+	log.Println("Connecting to browser...")
+	// Create a top-level context for the browser instance
+	ctx, cancel := chromedp.NewExecAllocator(context.Background(),
+		// Run headless with GPU, sandbox, and background features disabled
+		chromedp.Flag("headless", true),
+		chromedp.Flag("disable-gpu", true),
+		chromedp.Flag("no-sandbox", true),
+		chromedp.Flag("disable-background-networking", true),
+		chromedp.Flag("disable-default-apps", true),
+		chromedp.Flag("disable-extensions", true),
+		chromedp.Flag("disable-sync", true),
+		chromedp.Flag("disable-translate", true),
+	)
+	f.cancel = cancel
+	// Create a browser context
+	f.ctx, f.browserCancel = chromedp.NewContext(ctx)
+	log.Println("Connected.")
+	return &f
+}
+
+func (f *chromedpFetcher) Close() {
+	f.cancel()
+	f.browserCancel()
+}
+
+// FetchPage fetches HTML content using chromedp. If requireSelector is set,
+// it waits for that element to become visible before reading the page;
+// otherwise it just waits for the page to finish loading.
+func (f *chromedpFetcher) FetchPage(ctx context.Context, url, requireSelector string) (string, error) {
+	tabCtx, cancel := chromedp.NewContext(f.ctx)
+	defer cancel()
+
+	// Set a timeout per request.
+	tabCtx, cancelTimeout := context.WithTimeout(tabCtx, 30*time.Second)
+	defer cancelTimeout()
+
+	actions := []chromedp.Action{chromedp.Navigate(url)}
+	if requireSelector != "" {
+		actions = append(actions, chromedp.WaitVisible(requireSelector, chromedp.ByQuery))
+	} else {
+		actions = append(actions, chromedp.WaitReady("body", chromedp.ByQuery))
+	}
+	var htmlContent string
+	actions = append(actions, chromedp.OuterHTML("html", &htmlContent))
+
+	log.Printf("Fetching %q with headless browser.", url)
+	start := time.Now()
+	if err := chromedp.Run(tabCtx, actions...); err != nil {
+		return "", fmt.Errorf("chromedp failed: %v", err)
+	}
+	chromedpPageLoadSeconds.Observe(time.Since(start).Seconds())
+	return htmlContent, nil
+}
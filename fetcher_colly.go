@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	defaultUserAgent = "gophercon25-agenda-bot/1.0 (+https://github.com/katiehockman/gophercon2025)"
+)
+
+var (
+	fetchConcurrency = flag.Int("fetch-concurrency", 8, "Maximum number of concurrent net/http page fetches")
+	fetchRateLimit   = flag.Duration("fetch-rate-limit", 200*time.Millisecond, "Minimum time between net/http requests to the same host")
+)
+
+// collyFetcher fetches pages with a plain net/http client, avoiding the Chromium dependency chromedpFetcher requires. It limits
+// in-flight requests and rate-limits requests per host.
+type collyFetcher struct {
+	client    *http.Client
+	userAgent string
+
+	sem chan struct{}
+
+	mu          sync.Mutex
+	lastRequest map[string]time.Time
+	rateLimit   time.Duration
+}
+
+// newCollyFetcher returns a Fetcher that fetches pages directly over
+// net/http, allowing at most concurrency requests in flight and waiting at
+// least rateLimit between requests to the same host.
+func newCollyFetcher(concurrency int, rateLimit time.Duration, userAgent string) *collyFetcher {
+	return &collyFetcher{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		userAgent:   userAgent,
+		sem:         make(chan struct{}, concurrency),
+		lastRequest: make(map[string]time.Time),
+		rateLimit:   rateLimit,
+	}
+}
+
+func (c *collyFetcher) Close() {}
+
+// FetchPage fetches HTML content with a plain HTTP GET. requireSelector is
+// unused here: whether the result is good enough is judged by the caller
+// (see autoFetcher.FetchPage), since a plain GET has no way to wait for
+// client-side rendering.
+func (c *collyFetcher) FetchPage(ctx context.Context, rawURL, requireSelector string) (string, error) {
+	select {
+	case c.sem <- struct{}{}:
+		defer func() { <-c.sem }()
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	if err := c.waitForHost(ctx, rawURL); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("building request for %s: %w", rawURL, err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetching %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching %s: unexpected status %s", rawURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response body from %s: %w", rawURL, err)
+	}
+	return string(body), nil
+}
+
+// waitForHost blocks until rateLimit has elapsed since the last request to
+// rawURL's host, or ctx is canceled.
+func (c *collyFetcher) waitForHost(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", rawURL, err)
+	}
+
+	c.mu.Lock()
+	next := c.lastRequest[u.Host].Add(c.rateLimit)
+	if now := time.Now(); next.Before(now) {
+		next = now
+	}
+	c.lastRequest[u.Host] = next
+	c.mu.Unlock()
+
+	wait := time.Until(next)
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// httpAddr is the address to serve the MCP server over Streamable HTTP on,
+// e.g. ":8080". When empty, the server runs over stdio instead.
+var httpAddr = flag.String("http", "", "Address to serve the MCP server over Streamable HTTP (e.g. :8080). If empty, runs over stdio.")
+
+// runHTTP serves server over the Streamable HTTP transport with SSE for
+// server-to-client notifications, alongside a /healthz endpoint. It blocks
+// until ctx is canceled, then shuts the HTTP server down gracefully.
+func runHTTP(ctx context.Context, server *mcp.Server, addr string) error {
+	// NewStreamableHTTPHandler manages the Mcp-Session-Id header itself, so
+	// multiple concurrent clients can share this one running server.
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return server
+	}, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("/", handler)
+	mux.HandleFunc("/healthz", healthzHandler)
+
+	httpServer := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("Serving MCP over Streamable HTTP on %s", addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			serveErr <- err
+		}
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+		log.Printf("Shutting down HTTP server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx)
+	}
+}
+
+// healthzHandler reports whether sessions have finished their initial load.
+func healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	select {
+	case <-sessionsReady:
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+	default:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, "sessions not ready")
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -15,6 +18,12 @@ func main() {
 		panic(err)
 	}
 
+	// Cancel on SIGINT/SIGTERM so both transports can shut down gracefully.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveMetrics(*metricsAddr)
+
 	// Create the MCP server.
 	server := mcp.NewServer(&mcp.Implementation{
 		Name:    "gophercon25",
@@ -26,32 +35,63 @@ func main() {
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_sessions",
 		Description: "Lists all GopherCon 2025 sessions with all relevant information.",
-	}, ListSessions)
+	}, instrumentTool("list_sessions", ListSessions))
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "get_session_details",
 		Description: "Get detailed information about a specific GopherCon session by ID",
-	}, SessionByID)
+	}, instrumentTool("get_session_details", SessionByID))
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "search_sessions",
+		Description: "Search GopherCon sessions by a free-text query matched against title, description, and speakers, ranked by relevance.",
+	}, instrumentTool("search_sessions", SearchSessions))
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "sessions_by_speaker",
+		Description: "List GopherCon sessions featuring a given speaker.",
+	}, instrumentTool("sessions_by_speaker", SessionsBySpeaker))
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "sessions_by_day",
+		Description: "List GopherCon sessions happening on a given day.",
+	}, instrumentTool("sessions_by_day", SessionsByDay))
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "sessions_by_track",
+		Description: "List GopherCon sessions in a given track or location.",
+	}, instrumentTool("sessions_by_track", SessionsByTrack))
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "scrape_status",
+		Description: "Report how much of the GopherCon agenda has been scraped so far, so a client can decide whether to wait or proceed with partial data.",
+	}, instrumentTool("scrape_status", ScrapeStatus))
+
+	// Add resources and prompts.
+	registerResources(server)
+	registerPrompts(server)
+
+	// Start the server, either over HTTP or stdio.
+	if *httpAddr != "" {
+		if err := runHTTP(ctx, server, *httpAddr); err != nil {
+			log.Printf("HTTP server failed: %v", err)
+		}
+		return
+	}
 
-	// Start the server.
 	log.Printf("Starting GopherCon agenda server...")
-	if err := server.Run(context.Background(), &mcp.StdioTransport{}); err != nil {
+	if err := server.Run(ctx, &mcp.StdioTransport{}); err != nil {
 		log.Printf("Server failed: %v", err)
 	}
 }
 
 // ListSessions is a tool that returns all data about all loaded sessions.
-func ListSessions(ctx context.Context, _ *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, SessionsResult, error) {
-	// Block until sessions are ready.
-	<-sessionsReady
+// If sessions aren't ready yet and the caller attached a progress token,
+// it returns whatever's loaded so far instead of blocking.
+func ListSessions(ctx context.Context, req *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, SessionsResult, error) {
+	waitOrPartial(ctx, req)
 
 	// Tool 1: Get all sessions.
 	return nil, SessionsResult{Sessions: sessions()}, nil
 }
 
 // SessionByID is a tool that returns all data about a specific session by its ID.
-func SessionByID(ctx context.Context, _ *mcp.CallToolRequest, params SessionIDParams) (*mcp.CallToolResult, SessionsResult, error) {
-	// Block until sessions are ready.
-	<-sessionsReady
+func SessionByID(ctx context.Context, req *mcp.CallToolRequest, params SessionIDParams) (*mcp.CallToolResult, SessionsResult, error) {
+	waitOrPartial(ctx, req)
 
 	// Tool 2: Get session details by ID.
 	session, exists := sessionByID(params.SessionID)
@@ -91,6 +131,9 @@ type Session struct {
 	Location    string   `json:"location,omitempty"`
 	Speakers    []string `json:"speakers,omitempty"`
 	Duration    string   `json:"duration,omitempty"`
+	// Score is the relevance score assigned by search_sessions; it is zero
+	// (and omitted) for sessions returned by any other tool.
+	Score float64 `json:"score,omitempty"`
 }
 
 var (
@@ -100,3 +143,12 @@ var (
 
 // Channel to signal when sessions are fully loaded.
 var sessionsReady = make(chan bool)
+
+// sessionsReadyOnce guards sessionsReady, which may be closed either
+// immediately from an on-disk cache or later once a live fetch completes.
+var sessionsReadyOnce sync.Once
+
+// markSessionsReady closes sessionsReady, if it hasn't been already.
+func markSessionsReady() {
+	sessionsReadyOnce.Do(func() { close(sessionsReady) })
+}
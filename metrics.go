@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricsAddr is the address to serve Prometheus metrics on, e.g. ":9090".
+// When empty, metrics are not served.
+var metricsAddr = flag.String("metrics-addr", "", "Address to serve Prometheus metrics on (e.g. :9090). If empty, metrics are not served.")
+
+var (
+	sessionsFetchedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "sessions_fetched_total",
+		Help: "Total number of sessions successfully fetched and parsed.",
+	})
+
+	sessionFetchFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "session_fetch_failures_total",
+		Help: "Total number of session fetch failures, by reason.",
+	}, []string{"reason"})
+
+	sessionFetchDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "session_fetch_duration_seconds",
+		Help:    "Time to fetch and parse a single session, including retries.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	chromedpPageLoadSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "chromedp_page_load_seconds",
+		Help:    "Time for chromedp to load a single session page.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	workerRetriesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "worker_retries_total",
+		Help: "Total number of session fetch retries across all workers.",
+	})
+
+	toolCallsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mcp_tool_calls_total",
+		Help: "Total number of MCP tool calls, by tool name.",
+	}, []string{"tool"})
+
+	toolCallDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mcp_tool_call_duration_seconds",
+		Help:    "Latency of MCP tool calls, by tool name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"tool"})
+)
+
+// serveMetrics starts a Prometheus /metrics HTTP server on addr in the
+// background. It is a no-op when addr is empty.
+func serveMetrics(addr string) {
+	if addr == "" {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		log.Printf("Serving Prometheus metrics on %s", addr)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Printf("Metrics server failed: %v", err)
+		}
+	}()
+}
+
+// instrumentTool wraps an MCP tool handler with call-count and latency
+// metrics, labeled by tool name.
+func instrumentTool[In, Out any](name string, handler func(context.Context, *mcp.CallToolRequest, In) (*mcp.CallToolResult, Out, error)) func(context.Context, *mcp.CallToolRequest, In) (*mcp.CallToolResult, Out, error) {
+	return func(ctx context.Context, req *mcp.CallToolRequest, in In) (*mcp.CallToolResult, Out, error) {
+		start := time.Now()
+		result, out, err := handler(ctx, req, in)
+		toolCallsTotal.WithLabelValues(name).Inc()
+		toolCallDurationSeconds.WithLabelValues(name).Observe(time.Since(start).Seconds())
+		return result, out, err
+	}
+}
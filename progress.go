@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// scrapeProgress tracks the state of the in-flight (or most recent) full
+// scrape, so the scrape_status tool and blocked-on-startup tool calls can
+// report on it instead of just blocking silently.
+type scrapeProgress struct {
+	mu       sync.RWMutex
+	total    int
+	loaded   int
+	failures []string
+	started  time.Time
+	done     bool
+
+	subscribers map[chan struct{}]struct{}
+}
+
+var progress = &scrapeProgress{}
+
+// start resets progress for a new scrape of total sessions.
+func (p *scrapeProgress) start(total int) {
+	p.mu.Lock()
+	p.total = total
+	p.loaded = 0
+	p.failures = nil
+	p.started = time.Now()
+	p.done = false
+	p.mu.Unlock()
+	p.notify()
+}
+
+// recordSuccess marks one more session as loaded and wakes subscribers.
+func (p *scrapeProgress) recordSuccess() {
+	p.mu.Lock()
+	p.loaded++
+	p.mu.Unlock()
+	p.notify()
+}
+
+// recordFailure marks sessionID as failed and wakes subscribers.
+func (p *scrapeProgress) recordFailure(sessionID string) {
+	p.mu.Lock()
+	p.failures = append(p.failures, sessionID)
+	p.mu.Unlock()
+	p.notify()
+}
+
+// finish marks the scrape complete and wakes subscribers one last time.
+func (p *scrapeProgress) finish() {
+	p.mu.Lock()
+	p.done = true
+	p.mu.Unlock()
+	p.notify()
+}
+
+// snapshot returns the current loaded/total counts, failed session IDs,
+// whether the scrape has finished, and an estimated time to completion.
+func (p *scrapeProgress) snapshot() (loaded, total int, failures []string, done bool, eta time.Duration) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	loaded, total, done = p.loaded, p.total, p.done
+	failures = append([]string(nil), p.failures...)
+
+	if done || loaded == 0 || total == 0 {
+		return loaded, total, failures, done, 0
+	}
+	perSession := time.Since(p.started) / time.Duration(loaded)
+	return loaded, total, failures, done, perSession * time.Duration(total-loaded)
+}
+
+// subscribe returns a channel that receives a value after progress changes,
+// and an unsubscribe func the caller must run once it's done reading, so
+// notify stops iterating over it. The channel holds at most one pending
+// value, so changes that arrive before the reader drains it are coalesced.
+func (p *scrapeProgress) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	p.mu.Lock()
+	if p.subscribers == nil {
+		p.subscribers = make(map[chan struct{}]struct{})
+	}
+	p.subscribers[ch] = struct{}{}
+	p.mu.Unlock()
+
+	return ch, func() {
+		p.mu.Lock()
+		delete(p.subscribers, ch)
+		p.mu.Unlock()
+	}
+}
+
+// notify wakes every subscriber without blocking.
+func (p *scrapeProgress) notify() {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for ch := range p.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// waitOrPartial blocks a tool handler until sessions are ready. If the
+// client attached a progress token to this call and sessions aren't ready
+// yet, it instead returns immediately — so the tool can respond with
+// whatever's loaded so far — while progress notifications for that token
+// stream in the background until the scrape completes.
+func waitOrPartial(ctx context.Context, req *mcp.CallToolRequest) {
+	select {
+	case <-sessionsReady:
+		return
+	default:
+	}
+
+	token := progressToken(req)
+	if token == nil {
+		<-sessionsReady
+		return
+	}
+	go streamScrapeProgress(context.Background(), req.Session, token)
+}
+
+// progressToken extracts the MCP progress token from a tool call's _meta
+// field, if the client set one.
+func progressToken(req *mcp.CallToolRequest) any {
+	if req == nil || req.Params == nil {
+		return nil
+	}
+	return req.Params.GetProgressToken()
+}
+
+// streamScrapeProgress sends notifications/progress for token on session as
+// the background scrape proceeds, until it completes or ctx is canceled.
+func streamScrapeProgress(ctx context.Context, session *mcp.ServerSession, token any) {
+	updates, unsubscribe := progress.subscribe()
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sessionsReady:
+			loaded, total, _, _, _ := progress.snapshot()
+			notifyProgress(ctx, session, token, loaded, total)
+			return
+		case <-updates:
+			loaded, total, _, done, _ := progress.snapshot()
+			notifyProgress(ctx, session, token, loaded, total)
+			if done {
+				return
+			}
+		}
+	}
+}
+
+// notifyProgress sends a single notifications/progress message.
+func notifyProgress(ctx context.Context, session *mcp.ServerSession, token any, loaded, total int) {
+	if session == nil || token == nil {
+		return
+	}
+	if err := session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: token,
+		Progress:      float64(loaded),
+		Total:         float64(total),
+	}); err != nil {
+		log.Printf("Failed to send scrape progress notification: %v", err)
+	}
+}
+
+// ScrapeStatusResult is the result of the scrape_status tool.
+type ScrapeStatusResult struct {
+	Loaded   int      `json:"loaded"`
+	Total    int      `json:"total"`
+	Failures []string `json:"failures,omitempty"`
+	Done     bool     `json:"done"`
+	ETA      string   `json:"eta,omitempty"`
+}
+
+// ScrapeStatus is a tool that reports on the progress of the initial (or
+// most recent) scrape, so a client can decide whether to wait or proceed
+// with partial data.
+func ScrapeStatus(ctx context.Context, _ *mcp.CallToolRequest, _ any) (*mcp.CallToolResult, ScrapeStatusResult, error) {
+	loaded, total, failures, done, eta := progress.snapshot()
+	result := ScrapeStatusResult{Loaded: loaded, Total: total, Failures: failures, Done: done}
+	if !done && eta > 0 {
+		result.ETA = eta.Round(time.Second).String()
+	}
+	return nil, result, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// registerPrompts adds MCP Prompts that help clients build a personal
+// schedule, spot time conflicts, and summarize a track.
+func registerPrompts(server *mcp.Server) {
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "build_my_schedule",
+		Description: "Build a personal GopherCon schedule around a list of interests, speakers, or session IDs.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "interests", Description: "Comma-separated topics, speakers, or session IDs to prioritize.", Required: true},
+		},
+	}, buildMyScheduleMCPPrompt)
+
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "find_conflicts_between_talks",
+		Description: "Find GopherCon sessions that overlap in time given a list of session IDs.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "session_ids", Description: "Comma-separated session IDs to check for time conflicts.", Required: true},
+		},
+	}, findConflictsBetweenTalksMCPPrompt)
+
+	server.AddPrompt(&mcp.Prompt{
+		Name:        "summarize_track",
+		Description: "Summarize all GopherCon sessions in a given track or location.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "track", Description: `Track or location name, e.g. "Ballroom A".`, Required: true},
+		},
+	}, summarizeTrackMCPPrompt)
+}
+
+func buildMyScheduleMCPPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	interests := req.Params.Arguments["interests"]
+	return &mcp.GetPromptResult{
+		Description: "Build a personal GopherCon schedule.",
+		Messages: []*mcp.PromptMessage{{
+			Role: "user",
+			Content: &mcp.TextContent{Text: fmt.Sprintf(
+				"Using the list_sessions and search_sessions tools, build me a personal GopherCon 2025 "+
+					"schedule that covers these interests: %s. Flag any sessions that overlap in time.", interests),
+			},
+		}},
+	}, nil
+}
+
+func findConflictsBetweenTalksMCPPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	sessionIDs := req.Params.Arguments["session_ids"]
+	return &mcp.GetPromptResult{
+		Description: "Find time conflicts between sessions.",
+		Messages: []*mcp.PromptMessage{{
+			Role: "user",
+			Content: &mcp.TextContent{Text: fmt.Sprintf(
+				"Using get_session_details, look up these GopherCon session IDs: %s. Tell me which of them "+
+					"overlap in time, using their date, time, and duration fields.", sessionIDs),
+			},
+		}},
+	}, nil
+}
+
+func summarizeTrackMCPPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	track := req.Params.Arguments["track"]
+	return &mcp.GetPromptResult{
+		Description: "Summarize a GopherCon track.",
+		Messages: []*mcp.PromptMessage{{
+			Role: "user",
+			Content: &mcp.TextContent{Text: fmt.Sprintf(
+				"Using sessions_by_track, fetch all GopherCon 2025 sessions in the %q track and summarize "+
+					"the common themes across their titles and descriptions.", track),
+			},
+		}},
+	}, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// registerResources adds the MCP Resources exposing the agenda as JSON per
+// session, an iCalendar feed, and a Markdown schedule.
+func registerResources(server *mcp.Server) {
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: "gophercon://sessions/{id}",
+		Name:        "session",
+		Description: "A single GopherCon session as JSON, by session ID.",
+		MIMEType:    "application/json",
+	}, sessionResource)
+
+	server.AddResource(&mcp.Resource{
+		URI:         "gophercon://agenda.ics",
+		Name:        "agenda.ics",
+		Description: "The full GopherCon agenda as an RFC 5545 iCalendar feed.",
+		MIMEType:    "text/calendar",
+	}, agendaICSResource)
+
+	server.AddResource(&mcp.Resource{
+		URI:         "gophercon://schedule.md",
+		Name:        "schedule.md",
+		Description: "The GopherCon agenda as a day-by-day Markdown schedule.",
+		MIMEType:    "text/markdown",
+	}, scheduleMarkdownResource)
+}
+
+// sessionResource serves gophercon://sessions/{id}.
+func sessionResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	<-sessionsReady
+
+	id := strings.TrimPrefix(req.Params.URI, "gophercon://sessions/")
+	session, ok := sessionByID(id)
+	if !ok {
+		return nil, fmt.Errorf("session %s not found", id)
+	}
+
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling session %s: %w", id, err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      req.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(data),
+		}},
+	}, nil
+}
+
+// agendaICSResource serves gophercon://agenda.ics.
+func agendaICSResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	<-sessionsReady
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      req.Params.URI,
+			MIMEType: "text/calendar",
+			Text:     buildAgendaICS(agendaLocation()),
+		}},
+	}, nil
+}
+
+// scheduleMarkdownResource serves gophercon://schedule.md.
+func scheduleMarkdownResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	<-sessionsReady
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{
+			URI:      req.Params.URI,
+			MIMEType: "text/markdown",
+			Text:     buildScheduleMarkdown(),
+		}},
+	}, nil
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var (
+	searchIndexMutex sync.RWMutex
+	searchIndex      = make(map[string][]string) // word -> session IDs containing it
+)
+
+// rebuildSearchIndex rebuilds the in-memory inverted index over session
+// titles, descriptions, and speakers from the current sessionsMap. Call this
+// any time sessionsMap is mutated so search results stay current.
+func rebuildSearchIndex() {
+	index := make(map[string][]string)
+	for _, session := range sessions() {
+		seen := make(map[string]bool)
+		for _, word := range indexWords(session) {
+			if seen[word] {
+				continue
+			}
+			seen[word] = true
+			index[word] = append(index[word], session.ID)
+		}
+	}
+
+	searchIndexMutex.Lock()
+	searchIndex = index
+	searchIndexMutex.Unlock()
+}
+
+// indexWords returns the tokenized words to index for a session.
+func indexWords(session Session) []string {
+	words := tokenize(session.Title)
+	words = append(words, tokenize(session.Description)...)
+	for _, speaker := range session.Speakers {
+		words = append(words, tokenize(speaker)...)
+	}
+	return words
+}
+
+// tokenize lowercases s and splits it into word tokens, dropping punctuation.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// searchSessions ranks sessions by how many query terms match in their
+// title, description, or speakers, scored as the fraction of query terms
+// found, using the in-memory inverted index so the search is O(query terms)
+// rather than O(sessions).
+func searchSessions(query string) []Session {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	searchIndexMutex.RLock()
+	scores := make(map[string]int, len(terms))
+	for _, term := range terms {
+		for _, id := range searchIndex[term] {
+			scores[id]++
+		}
+	}
+	searchIndexMutex.RUnlock()
+
+	results := make([]Session, 0, len(scores))
+	for id, matched := range scores {
+		session, ok := sessionByID(id)
+		if !ok {
+			continue
+		}
+		session.Score = float64(matched) / float64(len(terms))
+		results = append(results, session)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+	return results
+}
+
+// sessionsBySpeaker returns sessions where any speaker name contains query,
+// case-insensitively.
+func sessionsBySpeaker(query string) []Session {
+	query = strings.ToLower(query)
+	var results []Session
+	for _, session := range sessions() {
+		for _, speaker := range session.Speakers {
+			if strings.Contains(strings.ToLower(speaker), query) {
+				results = append(results, session)
+				break
+			}
+		}
+	}
+	return results
+}
+
+// sessionsByDay returns sessions whose Date contains query, case-insensitively.
+func sessionsByDay(query string) []Session {
+	query = strings.ToLower(query)
+	var results []Session
+	for _, session := range sessions() {
+		if strings.Contains(strings.ToLower(session.Date), query) {
+			results = append(results, session)
+		}
+	}
+	return results
+}
+
+// sessionsByTrack returns sessions whose Location contains query,
+// case-insensitively.
+func sessionsByTrack(query string) []Session {
+	query = strings.ToLower(query)
+	var results []Session
+	for _, session := range sessions() {
+		if strings.Contains(strings.ToLower(session.Location), query) {
+			results = append(results, session)
+		}
+	}
+	return results
+}
+
+// SearchSessionsParams are the parameters for the search_sessions tool.
+type SearchSessionsParams struct {
+	// Query is matched against session titles, descriptions, and speakers.
+	Query string `json:"query"`
+}
+
+// SpeakerParams are the parameters for the sessions_by_speaker tool.
+type SpeakerParams struct {
+	// Speaker is matched against session speaker names.
+	Speaker string `json:"speaker"`
+}
+
+// DayParams are the parameters for the sessions_by_day tool.
+type DayParams struct {
+	// Day is matched as a case-insensitive substring of a session's date,
+	// e.g. "September 15" or "Monday".
+	Day string `json:"day"`
+}
+
+// TrackParams are the parameters for the sessions_by_track tool.
+type TrackParams struct {
+	// Track is matched against a session's location/room.
+	Track string `json:"track"`
+}
+
+// SearchSessions is a tool that ranks sessions by relevance to a free-text query.
+func SearchSessions(ctx context.Context, req *mcp.CallToolRequest, params SearchSessionsParams) (*mcp.CallToolResult, SessionsResult, error) {
+	waitOrPartial(ctx, req)
+	return nil, SessionsResult{Sessions: searchSessions(params.Query)}, nil
+}
+
+// SessionsBySpeaker is a tool that returns sessions featuring a given speaker.
+func SessionsBySpeaker(ctx context.Context, req *mcp.CallToolRequest, params SpeakerParams) (*mcp.CallToolResult, SessionsResult, error) {
+	waitOrPartial(ctx, req)
+	return nil, SessionsResult{Sessions: sessionsBySpeaker(params.Speaker)}, nil
+}
+
+// SessionsByDay is a tool that returns sessions happening on a given day.
+func SessionsByDay(ctx context.Context, req *mcp.CallToolRequest, params DayParams) (*mcp.CallToolResult, SessionsResult, error) {
+	waitOrPartial(ctx, req)
+	return nil, SessionsResult{Sessions: sessionsByDay(params.Day)}, nil
+}
+
+// SessionsByTrack is a tool that returns sessions in a given track/location.
+func SessionsByTrack(ctx context.Context, req *mcp.CallToolRequest, params TrackParams) (*mcp.CallToolResult, SessionsResult, error) {
+	waitOrPartial(ctx, req)
+	return nil, SessionsResult{Sessions: sessionsByTrack(params.Track)}, nil
+}
@@ -6,13 +6,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
-	"github.com/chromedp/chromedp"
 )
 
 var sessionsMap = make(map[string]Session)
@@ -39,41 +39,24 @@ func sessionByID(id string) (Session, bool) {
 	return session, exists
 }
 
-func newFetcher() *fetcher {
-	var f fetcher
-	log.Println("Connecting to browser...")
-	// Create a top-level context for the browser instance
-	ctx, cancel := chromedp.NewExecAllocator(context.Background(),
-		// Disable loading images/fonts for speed
-		chromedp.Flag("headless", true),
-		chromedp.Flag("disable-gpu", true),
-		chromedp.Flag("no-sandbox", true),
-		chromedp.Flag("disable-background-networking", true),
-		chromedp.Flag("disable-default-apps", true),
-		chromedp.Flag("disable-extensions", true),
-		chromedp.Flag("disable-sync", true),
-		chromedp.Flag("disable-translate", true),
-	)
-	f.cancel = cancel
-	// Create a browser context
-	f.ctx, f.browserCancel = chromedp.NewContext(ctx)
-	log.Println("Connected.")
-	return &f
+// scraper fetches and parses GopherCon sessions using a Fetcher.
+type scraper struct {
+	client Fetcher
 }
 
-func (f *fetcher) Close() {
-	f.cancel()
-	f.browserCancel()
-}
+// fetch discovers and fetches all GopherCon sessions in parallel.
+func (s *scraper) fetch() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
 
-type fetcher struct {
-	ctx                   context.Context
-	cancel, browserCancel context.CancelFunc
-}
+	ids, err := discoverSessionIDs(ctx, s.client)
+	if err != nil {
+		log.Printf("Failed to discover session IDs from the agenda page, falling back to the hard-coded list: %v", err)
+		ids = fallbackSessionIDs
+	}
 
-// fetch fetches all GopherCon sessions in parallel
-func (f *fetcher) fetch() {
-	log.Printf("Loading %d sessions from GopherCon 2025 using parallel processing...", len(sessionIDs))
+	log.Printf("Loading %d sessions from GopherCon 2025 using parallel processing...", len(ids))
+	progress.start(len(ids))
 
 	// Configuration for parallel processing
 	maxWorkers := runtime.GOMAXPROCS(0) // Use number of available CPU cores
@@ -82,20 +65,20 @@ func (f *fetcher) fetch() {
 	log.Printf("Using %d workers for parallel processing.", maxWorkers)
 
 	// Create channels for coordination
-	sessionChan := make(chan string, len(sessionIDs))
-	resultChan := make(chan sessionResult, len(sessionIDs))
+	sessionChan := make(chan string, len(ids))
+	resultChan := make(chan sessionResult, len(ids))
 
 	// Start worker goroutines
 	var wg sync.WaitGroup
 	for i := range maxWorkers {
 		wg.Add(1)
-		go f.worker(i, sessionChan, resultChan, &wg, maxRetries)
+		go s.worker(ctx, i, sessionChan, resultChan, &wg, maxRetries)
 	}
 
 	// Send all session IDs to workers
 	go func() {
 		defer close(sessionChan)
-		for _, sessionID := range sessionIDs {
+		for _, sessionID := range ids {
 			sessionChan <- sessionID
 		}
 	}()
@@ -109,6 +92,8 @@ func (f *fetcher) fetch() {
 	for result := range resultChan {
 		if result.err != nil {
 			log.Printf("Error loading session %s: %v.", result.sessionID, result.err)
+			sessionFetchFailuresTotal.WithLabelValues(fetchFailureReason(result.err)).Inc()
+			progress.recordFailure(result.sessionID)
 			continue
 		}
 
@@ -117,61 +102,93 @@ func (f *fetcher) fetch() {
 		sessionsMap[result.session.ID] = result.session
 		sessionsMutex.Unlock()
 
+		cachePut(result.session.ID, result.session, result.contentHash)
+		sessionsFetchedTotal.Inc()
+		progress.recordSuccess()
 		log.Printf("Successfully loaded session %s: %s.", result.session.ID, result.session.Title)
 	}
 
 	log.Printf("Total sessions loaded: %d", len(sessionsMap))
-	close(sessionsReady)
+	rebuildSearchIndex()
+	progress.finish()
+	markSessionsReady()
 }
 
 // sessionResult is the result of loading a session.
 type sessionResult struct {
-	sessionID string
-	session   Session
-	err       error
+	sessionID   string
+	session     Session
+	contentHash string
+	err         error
 }
 
-func (f *fetcher) worker(id int, sessionChan <-chan string, resultChan chan<- sessionResult, wg *sync.WaitGroup, maxRetries int) {
+func (s *scraper) worker(ctx context.Context, id int, sessionChan <-chan string, resultChan chan<- sessionResult, wg *sync.WaitGroup, maxRetries int) {
 	defer wg.Done()
 
 	for sessionID := range sessionChan {
 		url := fmt.Sprintf("https://www.gophercon.com/agenda/session/%s", sessionID)
 
+		start := time.Now()
 		var session Session
+		var hash string
 		var err error
 
 		// Retry logic
 		for attempt := 1; attempt <= maxRetries; attempt++ {
-			session, err = f.parseSession(sessionID, url)
+			session, hash, err = s.parseSession(ctx, sessionID, url)
 			if err == nil {
 				break
 			}
 
 			if attempt < maxRetries {
 				log.Printf("Worker %d: Retry %d for session %s: %v", id, attempt, sessionID, err)
+				workerRetriesTotal.Inc()
 				time.Sleep(time.Duration(attempt) * time.Second) // Exponential backoff
 			}
 		}
+		sessionFetchDurationSeconds.Observe(time.Since(start).Seconds())
 
 		resultChan <- sessionResult{
-			sessionID: sessionID,
-			session:   session,
-			err:       err,
+			sessionID:   sessionID,
+			session:     session,
+			contentHash: hash,
+			err:         err,
 		}
 	}
 }
 
-// parseSession parses a single session from the HTML.
-func (f *fetcher) parseSession(sessionID, url string) (Session, error) {
-	htmlContent, err := f.fetchPage(url)
+// fetchFailureReason classifies an error from parseSession into a short,
+// low-cardinality label suitable for the session_fetch_failures_total metric.
+func fetchFailureReason(err error) string {
+	switch {
+	case strings.Contains(err.Error(), "failed to fetch session"):
+		return "fetch"
+	case strings.Contains(err.Error(), "failed to parse HTML"):
+		return "parse"
+	default:
+		return "other"
+	}
+}
+
+// parseSession parses a single session from the HTML, returning the parsed
+// Session along with a content hash of the page it was parsed from. If the
+// page's HTML hash matches the cached entry for sessionID, the cached
+// Session is returned without re-parsing.
+func (s *scraper) parseSession(ctx context.Context, sessionID, url string) (Session, string, error) {
+	htmlContent, err := s.client.FetchPage(ctx, url, ".session-title")
 	if err != nil {
-		return Session{}, fmt.Errorf("failed to fetch session %s: %v", sessionID, err)
+		return Session{}, "", fmt.Errorf("failed to fetch session %s: %v", sessionID, err)
+	}
+	hash := contentHash(htmlContent)
+
+	if entry, ok := cacheGet(sessionID); ok && entry.ContentHash == hash {
+		return entry.Session, hash, nil
 	}
 
 	// Parse the HTML to extract session information.
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
 	if err != nil {
-		return Session{}, fmt.Errorf("failed to parse HTML for session %s: %v", sessionID, err)
+		return Session{}, "", fmt.Errorf("failed to parse HTML for session %s: %v", sessionID, err)
 	}
 
 	// Extract session information from the HTML using specific selectors from the actual structure.
@@ -212,35 +229,54 @@ func (f *fetcher) parseSession(sessionID, url string) (Session, error) {
 
 	// Extract speakers from the speaker container
 	// TODO: Fix this as it is not working consistently.
-	doc.Find(".speaker-name").Each(func(i int, s *goquery.Selection) {
-		name := strings.TrimSpace(s.Text())
+	doc.Find(".speaker-name").Each(func(i int, sel *goquery.Selection) {
+		name := strings.TrimSpace(sel.Text())
 		if name != "" {
 			session.Speakers = append(session.Speakers, name)
 		}
 	})
 
-	return session, nil
+	return session, hash, nil
 }
 
-// fetchPage fetches HTML content using chromedp.
-func (f *fetcher) fetchPage(url string) (string, error) {
-	tabCtx, cancel := chromedp.NewContext(f.ctx)
-	defer cancel()
+// agendaURL is the GopherCon 2025 agenda page that links to every session.
+const agendaURL = "https://www.gophercon.com/agenda"
+
+// sessionIDPattern extracts a session ID from an agenda anchor's href.
+var sessionIDPattern = regexp.MustCompile(`/agenda/session/(\d+)`)
+
+// discoverSessionIDs crawls agendaURL and extracts session IDs from its
+// anchors, so the session list no longer needs to be a hard-coded slice.
+func discoverSessionIDs(ctx context.Context, client Fetcher) ([]string, error) {
+	// No requireSelector: the agenda listing page has no reason to contain
+	// .session-title, so don't let that fall the net/http fetch through to
+	// the headless browser on every scrape cycle.
+	html, err := client.FetchPage(ctx, agendaURL, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch agenda page: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse agenda page: %w", err)
+	}
 
-	// Set a timeout per request.
-	tabCtx, cancelTimeout := context.WithTimeout(tabCtx, 30*time.Second)
-	defer cancelTimeout()
-
-	log.Printf("Fetching %q.", url)
-	var htmlContent string
-	if err := chromedp.Run(tabCtx,
-		chromedp.Navigate(url),
-		chromedp.WaitVisible(`.session-title`, chromedp.ByQuery),
-		chromedp.OuterHTML("html", &htmlContent),
-	); err != nil {
-		return "", fmt.Errorf("chromedp failed: %v", err)
+	seen := make(map[string]bool)
+	var ids []string
+	doc.Find("a[href]").Each(func(_ int, sel *goquery.Selection) {
+		href, _ := sel.Attr("href")
+		match := sessionIDPattern.FindStringSubmatch(href)
+		if match == nil || seen[match[1]] {
+			return
+		}
+		seen[match[1]] = true
+		ids = append(ids, match[1])
+	})
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no session links found on agenda page")
 	}
-	return htmlContent, nil
+	return ids, nil
 }
 
 func loadSessions() error {
@@ -249,16 +285,50 @@ func loadSessions() error {
 		if err := loadSessionsFromFile(*dataFile); err != nil {
 			return fmt.Errorf("Failed to load sessions from file: %w", err)
 		}
-		close(sessionsReady)
+		markSessionsReady()
 		return nil
 	}
-	// Load sessions in the background.
+
+	// Warm up from the on-disk cache immediately, so sessionsReady can close
+	// without waiting on the network.
+	if err := loadCache(*cacheFile); err != nil {
+		log.Printf("Failed to load session cache from %s: %v", *cacheFile, err)
+	}
+	if snapshot := cacheSnapshot(); len(snapshot) > 0 {
+		sessionsMutex.Lock()
+		for id, entry := range snapshot {
+			sessionsMap[id] = entry.Session
+		}
+		sessionsMutex.Unlock()
+		rebuildSearchIndex()
+		log.Printf("Serving %d sessions from cache while refreshing in the background.", len(snapshot))
+		markSessionsReady()
+	}
+
+	// Fetch (and periodically refresh) sessions in the background.
 	go func() {
 		log.Println("Loading GopherCon agenda sessions...")
-		fetcher := newFetcher()
-		defer fetcher.Close()
-		fetcher.fetch()
-		close(sessionsReady)
+		s := &scraper{client: newFetcher()}
+		defer s.client.Close()
+
+		s.fetch()
+		markSessionsReady()
+		if err := saveCache(*cacheFile); err != nil {
+			log.Printf("Failed to save session cache to %s: %v", *cacheFile, err)
+		}
+
+		if *refreshInterval <= 0 {
+			return
+		}
+		ticker := time.NewTicker(*refreshInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			log.Println("Refreshing GopherCon agenda sessions...")
+			s.fetch()
+			if err := saveCache(*cacheFile); err != nil {
+				log.Printf("Failed to save session cache to %s: %v", *cacheFile, err)
+			}
+		}
 	}()
 	return nil
 }
@@ -282,18 +352,21 @@ func loadSessionsFromFile(filename string) error {
 
 	// Load sessions into the global map
 	sessionsMutex.Lock()
-	defer sessionsMutex.Unlock()
 	for _, session := range sessionsData {
 		sessionsMap[session.ID] = session
 		log.Printf("Added session %s: %s", session.ID, session.Title)
 	}
+	total := len(sessionsMap)
+	sessionsMutex.Unlock()
 
-	log.Printf("Total sessions in map: %d", len(sessionsMap))
+	log.Printf("Total sessions in map: %d", total)
+	rebuildSearchIndex()
 	return nil
 }
 
-// sessionIDs are hard-coded session IDs for GopherCon 2025.
-var sessionIDs = []string{
+// fallbackSessionIDs are hard-coded session IDs for GopherCon 2025, used
+// when discoverSessionIDs can't crawl the agenda page.
+var fallbackSessionIDs = []string{
 	"1545653", "1557197", "1590663", "1545640", "1590103", "1594224", "1545643", "1545641", "1557237", "1557206",
 	"1545646", "1557199", "1557216", "1545650", "1545651", "1565804", "1557235", "1545655", "1545656", "1545657",
 	"1545658", "1545682", "1572365", "1545661", "1545662", "1545663", "1545664", "1557386", "1557394", "1545667",